@@ -0,0 +1,118 @@
+package null
+
+import (
+	"bytes"
+	"encoding/gob"
+	"math"
+	"testing"
+)
+
+func TestInt8BinaryRoundTrip(t *testing.T) {
+	for _, tc := range []Int8{Int8From(-128), Int8From(127), NewInt8(0, false)} {
+		data, err := tc.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary(%+v): %v", tc, err)
+		}
+		var got Int8
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary(%x): %v", data, err)
+		}
+		if got != tc {
+			t.Errorf("round trip %+v: got %+v via %x", tc, got, data)
+		}
+	}
+}
+
+func TestInt8BinaryTruncated(t *testing.T) {
+	var i Int8
+	if err := i.UnmarshalBinary(nil); err == nil {
+		t.Error("UnmarshalBinary(nil): want error, got nil")
+	}
+	if err := i.UnmarshalBinary([]byte{1}); err == nil {
+		t.Error("UnmarshalBinary(too short): want error, got nil")
+	}
+}
+
+func TestInt8GobRoundTrip(t *testing.T) {
+	want := Int8From(42)
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatalf("gob encode: %v", err)
+	}
+	var got Int8
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("gob decode: %v", err)
+	}
+	if got != want {
+		t.Errorf("gob round trip: got %+v, want %+v", got, want)
+	}
+}
+
+func TestUint64BinaryRoundTrip(t *testing.T) {
+	for _, tc := range []Uint64{Uint64From(math.MaxUint64), Uint64From(0), NewUint64(0, false)} {
+		data, err := tc.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary(%+v): %v", tc, err)
+		}
+		var got Uint64
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary(%x): %v", data, err)
+		}
+		if got != tc {
+			t.Errorf("round trip %+v: got %+v via %x", tc, got, data)
+		}
+	}
+}
+
+func TestUintBinaryRoundTripIsPlatformPortable(t *testing.T) {
+	// Uint is always encoded in a fixed 8 bytes, regardless of the native
+	// platform's strconv.IntSize, so the wire format is portable.
+	want := UintFrom(1<<32 + 7)
+	data, err := want.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary(%+v): %v", want, err)
+	}
+	if len(data) != 9 {
+		t.Fatalf("MarshalBinary(%+v): got %d bytes, want 9", want, len(data))
+	}
+	var got Uint
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary(%x): %v", data, err)
+	}
+	if got != want {
+		t.Errorf("round trip %+v: got %+v via %x", want, got, data)
+	}
+}
+
+func TestFloat32BinaryRoundTrip(t *testing.T) {
+	for _, tc := range []Float32{
+		Float32From(3.14),
+		Float32From(float32(math.Inf(1))),
+		Float32From(float32(math.Inf(-1))),
+		NewFloat32(0, false),
+	} {
+		data, err := tc.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary(%+v): %v", tc, err)
+		}
+		var got Float32
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary(%x): %v", data, err)
+		}
+		if got != tc {
+			t.Errorf("round trip %+v: got %+v via %x", tc, got, data)
+		}
+	}
+}
+
+// TestInt8BinaryNonCanonicalTag documents the current wire format: any
+// nonzero tag byte (not just 1) is treated as "valid" when decoding.
+func TestInt8BinaryNonCanonicalTag(t *testing.T) {
+	var i Int8
+	if err := i.UnmarshalBinary([]byte{2, 42}); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+	if !i.Valid || i.Int8 != 42 {
+		t.Errorf("got %+v, want Valid=true Int8=42", i)
+	}
+}
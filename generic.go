@@ -0,0 +1,355 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+)
+
+// Numeric lists the scalar kinds that already have a dedicated nullable type
+// in this package (Int8, Uint8, Int16, Int32, Int64, Uint, Uint16, Uint32,
+// Uint64, Float32). Null[T] is a generic counterpart to those types: same
+// behavior, but parameterized so callers on Go 1.18+ can write
+// null.Null[uint16] instead of reaching for a dedicated named type.
+type Numeric interface {
+	~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~float32
+}
+
+// Null is a nullable numeric value parameterized over T.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Null[T Numeric] struct {
+	Val   T
+	Valid bool
+}
+
+// NewNull creates a new Null[T].
+func NewNull[T Numeric](v T, valid bool) Null[T] {
+	return Null[T]{Val: v, Valid: valid}
+}
+
+// NullFrom creates a new Null[T] that will always be valid.
+func NullFrom[T Numeric](v T) Null[T] {
+	return NewNull(v, true)
+}
+
+// NullFromPtr creates a new Null[T] that will be null if v is nil.
+func NullFromPtr[T Numeric](v *T) Null[T] {
+	if v == nil {
+		var zero T
+		return NewNull(zero, false)
+	}
+	return NewNull(*v, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (n Null[T]) ValueOrZero() T {
+	if !n.Valid {
+		var zero T
+		return zero
+	}
+	return n.Val
+}
+
+// SetValid changes this Null[T]'s value and also sets it to be non-null.
+func (n *Null[T]) SetValid(v T) {
+	n.Val = v
+	n.Valid = true
+}
+
+// Ptr returns a pointer to this Null[T]'s value, or a nil pointer if this Null[T] is null.
+func (n Null[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Val
+}
+
+// IsZero returns true for invalid Null[T]s, for future omitempty support (Go 1.4?)
+// A non-null Null[T] with a zero value will not be considered zero.
+func (n Null[T]) IsZero() bool {
+	return !n.Valid
+}
+
+// Equal returns true if both Null[T]s have the same value or are both null.
+func (n Null[T]) Equal(other Null[T]) bool {
+	return n.Valid == other.Valid && (!n.Valid || n.Val == other.Val)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Null[T] is null.
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return marshalNumeric(n.Val)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will not be considered a null Null[T].
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		n.Valid = false
+		return nil
+	}
+	v, err := unmarshalNumericJSON[T](data)
+	if err != nil {
+		return err
+	}
+	n.Val = v
+	n.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Null[T] is null.
+func (n Null[T]) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return marshalNumeric(n.Val)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Null[T] if the input is blank.
+// It will return an error if the input is not a number, blank, or "null".
+func (n *Null[T]) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		n.Valid = false
+		return nil
+	}
+	v, err := unmarshalNumericJSON[T](text)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	n.Val = v
+	n.Valid = true
+	return nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *Null[T]) Scan(value interface{}) error {
+	if value == nil {
+		var zero T
+		n.Val, n.Valid = zero, false
+		return nil
+	}
+	if err := scanNumeric(&n.Val, value); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return valueNumeric(n.Val)
+}
+
+// marshalNumeric renders v by delegating to the concrete Int8/Uint8/.../
+// Float32 type's own MarshalJSON, so the wire format has exactly one
+// implementation shared between Null[T] and the concrete types.
+func marshalNumeric[T Numeric](v T) ([]byte, error) {
+	switch x := any(v).(type) {
+	case int8:
+		return NewInt8(x, true).MarshalJSON()
+	case uint8:
+		return NewUint8(x, true).MarshalJSON()
+	case int16:
+		return NewInt16(x, true).MarshalJSON()
+	case int32:
+		return NewInt32(x, true).MarshalJSON()
+	case int64:
+		return NewInt64(x, true).MarshalJSON()
+	case uint:
+		return NewUint(x, true).MarshalJSON()
+	case uint16:
+		return NewUint16(x, true).MarshalJSON()
+	case uint32:
+		return NewUint32(x, true).MarshalJSON()
+	case uint64:
+		return NewUint64(x, true).MarshalJSON()
+	case float32:
+		return NewFloat32(x, true).MarshalJSON()
+	default:
+		return nil, fmt.Errorf("null: unsupported Null type %T", v)
+	}
+}
+
+// unmarshalNumericJSON decodes a JSON (or text) numeric/string token into T,
+// dispatching to the same precision-preserving strconv parsing the concrete
+// types use.
+func unmarshalNumericJSON[T Numeric](data []byte) (T, error) {
+	var zero T
+	switch any(zero).(type) {
+	case int8:
+		n, err := parseJSONInt(data, 8)
+		if err != nil {
+			return zero, err
+		}
+		return any(int8(n)).(T), nil
+	case uint8:
+		n, err := parseJSONUint(data, 8)
+		if err != nil {
+			return zero, err
+		}
+		return any(uint8(n)).(T), nil
+	case int16:
+		n, err := parseJSONInt(data, 16)
+		if err != nil {
+			return zero, err
+		}
+		return any(int16(n)).(T), nil
+	case int32:
+		n, err := parseJSONInt(data, 32)
+		if err != nil {
+			return zero, err
+		}
+		return any(int32(n)).(T), nil
+	case int64:
+		n, err := parseJSONInt(data, 64)
+		if err != nil {
+			return zero, err
+		}
+		return any(n).(T), nil
+	case uint:
+		n, err := parseJSONUint(data, strconv.IntSize)
+		if err != nil {
+			return zero, err
+		}
+		return any(uint(n)).(T), nil
+	case uint16:
+		n, err := parseJSONUint(data, 16)
+		if err != nil {
+			return zero, err
+		}
+		return any(uint16(n)).(T), nil
+	case uint32:
+		n, err := parseJSONUint(data, 32)
+		if err != nil {
+			return zero, err
+		}
+		return any(uint32(n)).(T), nil
+	case uint64:
+		n, err := parseJSONUint(data, 64)
+		if err != nil {
+			return zero, err
+		}
+		return any(n).(T), nil
+	case float32:
+		f, err := parseJSONFloat(data, 32)
+		if err != nil {
+			return zero, err
+		}
+		return any(float32(f)).(T), nil
+	default:
+		return zero, fmt.Errorf("null: unsupported Null type %T", zero)
+	}
+}
+
+// scanNumeric implements Scan for T by delegating to the concrete type's own
+// Scan, so the internal/convert coercions and sql.NullXxx shims each type
+// uses live in exactly one place.
+func scanNumeric[T Numeric](dest *T, value interface{}) error {
+	switch p := any(dest).(type) {
+	case *int8:
+		var v Int8
+		if err := v.Scan(value); err != nil {
+			return err
+		}
+		*p = v.Int8
+	case *uint8:
+		var v Uint8
+		if err := v.Scan(value); err != nil {
+			return err
+		}
+		*p = v.Byte
+	case *int16:
+		var v Int16
+		if err := v.Scan(value); err != nil {
+			return err
+		}
+		*p = v.Int16
+	case *int32:
+		var v Int32
+		if err := v.Scan(value); err != nil {
+			return err
+		}
+		*p = v.Int32
+	case *int64:
+		var v Int64
+		if err := v.Scan(value); err != nil {
+			return err
+		}
+		*p = v.Int64
+	case *uint:
+		var v Uint
+		if err := v.Scan(value); err != nil {
+			return err
+		}
+		*p = v.Uint
+	case *uint16:
+		var v Uint16
+		if err := v.Scan(value); err != nil {
+			return err
+		}
+		*p = v.Uint16
+	case *uint32:
+		var v Uint32
+		if err := v.Scan(value); err != nil {
+			return err
+		}
+		*p = v.Uint32
+	case *uint64:
+		var v Uint64
+		if err := v.Scan(value); err != nil {
+			return err
+		}
+		*p = v.Uint64
+	case *float32:
+		var v Float32
+		if err := v.Scan(value); err != nil {
+			return err
+		}
+		*p = v.Float32
+	default:
+		return fmt.Errorf("null: unsupported Scan destination type %T", dest)
+	}
+	return nil
+}
+
+// valueNumeric implements Value for T by delegating to the concrete type's
+// own Value, so the uint64-overflow-to-string handling lives in exactly one
+// place (Uint64.Value).
+func valueNumeric[T Numeric](v T) (driver.Value, error) {
+	switch x := any(v).(type) {
+	case int8:
+		return NewInt8(x, true).Value()
+	case uint8:
+		return NewUint8(x, true).Value()
+	case int16:
+		return NewInt16(x, true).Value()
+	case int32:
+		return NewInt32(x, true).Value()
+	case int64:
+		return NewInt64(x, true).Value()
+	case uint:
+		return NewUint(x, true).Value()
+	case uint16:
+		return NewUint16(x, true).Value()
+	case uint32:
+		return NewUint32(x, true).Value()
+	case uint64:
+		return NewUint64(x, true).Value()
+	case float32:
+		return NewFloat32(x, true).Value()
+	default:
+		return nil, fmt.Errorf("null: unsupported Null type %T", v)
+	}
+}
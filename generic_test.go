@@ -0,0 +1,59 @@
+package null
+
+import (
+	"math"
+	"testing"
+)
+
+func testNullRoundTrip[T Numeric](t *testing.T, want T) {
+	t.Helper()
+
+	n := NullFrom(want)
+	data, err := n.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON(%v): %v", want, err)
+	}
+	var gotJSON Null[T]
+	if err := gotJSON.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON(%s): %v", data, err)
+	}
+	if !gotJSON.Valid || gotJSON.Val != want {
+		t.Errorf("JSON round trip %v: got %v (valid=%v) via %s", want, gotJSON.Val, gotJSON.Valid, data)
+	}
+
+	dv, err := n.Value()
+	if err != nil {
+		t.Fatalf("Value(%v): %v", want, err)
+	}
+	var gotScan Null[T]
+	if err := gotScan.Scan(dv); err != nil {
+		t.Fatalf("Scan(%v): %v", dv, err)
+	}
+	if !gotScan.Valid || gotScan.Val != want {
+		t.Errorf("Scan/Value round trip %v: got %v (valid=%v) via %v", want, gotScan.Val, gotScan.Valid, dv)
+	}
+}
+
+func TestNullRoundTripAllNumericKinds(t *testing.T) {
+	testNullRoundTrip(t, int8(math.MinInt8))
+	testNullRoundTrip(t, int16(math.MinInt16))
+	testNullRoundTrip(t, int32(math.MinInt32))
+	testNullRoundTrip(t, int64(math.MinInt64))
+	testNullRoundTrip(t, uint(42))
+	testNullRoundTrip(t, uint8(math.MaxUint8))
+	testNullRoundTrip(t, uint16(math.MaxUint16))
+	testNullRoundTrip(t, uint32(math.MaxUint32))
+	testNullRoundTrip(t, uint64(math.MaxUint64))
+	testNullRoundTrip(t, float32(3.14))
+}
+
+func TestNullUnmarshalJSONNull(t *testing.T) {
+	var n Null[int32]
+	n.Val = 7
+	if err := n.UnmarshalJSON([]byte("null")); err != nil {
+		t.Fatalf("UnmarshalJSON(null): %v", err)
+	}
+	if n.Valid {
+		t.Errorf("got Valid=true, want false")
+	}
+}
@@ -0,0 +1,138 @@
+// Package convert implements safe numeric coercions shared by the sub-word
+// integer and float types in null and zero, whose database/sql/driver.Scan
+// methods cannot rely on a stdlib sql.NullXxx type to do the work.
+package convert
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// ConvertAssign copies src into dest, converting between numeric
+// representations as needed. dest must be a pointer to one of int8, uint,
+// uint16, uint32, uint64, or float32. src is expected to be one of the types
+// a database/sql driver hands to Scan: nil, int64, float64, []byte, or
+// string. The conversion is range-checked; a src value that does not fit in
+// dest returns an error instead of silently truncating.
+func ConvertAssign(dest interface{}, src interface{}) error {
+	switch d := dest.(type) {
+	case *int8:
+		n, err := toInt64(src)
+		if err != nil {
+			return err
+		}
+		if n < math.MinInt8 || n > math.MaxInt8 {
+			return fmt.Errorf("null: converting %v to int8 overflows", n)
+		}
+		*d = int8(n)
+	case *uint:
+		n, err := toUint64(src)
+		if err != nil {
+			return err
+		}
+		if strconv.IntSize == 32 && n > math.MaxUint32 {
+			return fmt.Errorf("null: converting %v to uint overflows", n)
+		}
+		*d = uint(n)
+	case *uint16:
+		n, err := toUint64(src)
+		if err != nil {
+			return err
+		}
+		if n > math.MaxUint16 {
+			return fmt.Errorf("null: converting %v to uint16 overflows", n)
+		}
+		*d = uint16(n)
+	case *uint32:
+		n, err := toUint64(src)
+		if err != nil {
+			return err
+		}
+		if n > math.MaxUint32 {
+			return fmt.Errorf("null: converting %v to uint32 overflows", n)
+		}
+		*d = uint32(n)
+	case *uint64:
+		n, err := toUint64(src)
+		if err != nil {
+			return err
+		}
+		*d = n
+	case *float32:
+		f, err := toFloat64(src)
+		if err != nil {
+			return err
+		}
+		*d = float32(f)
+	default:
+		return fmt.Errorf("null: unsupported Scan destination type %T", dest)
+	}
+	return nil
+}
+
+func toInt64(src interface{}) (int64, error) {
+	switch v := src.(type) {
+	case int64:
+		return v, nil
+	case []byte:
+		n, err := strconv.ParseInt(string(v), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("null: couldn't convert %v to int64: %w", src, err)
+		}
+		return n, nil
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("null: couldn't convert %v to int64: %w", src, err)
+		}
+		return n, nil
+	default:
+		return 0, fmt.Errorf("null: couldn't convert %v (%T) to int64", src, src)
+	}
+}
+
+func toUint64(src interface{}) (uint64, error) {
+	switch v := src.(type) {
+	case int64:
+		if v < 0 {
+			return 0, fmt.Errorf("null: converting %v to uint64 overflows", v)
+		}
+		return uint64(v), nil
+	case []byte:
+		n, err := strconv.ParseUint(string(v), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("null: couldn't convert %v to uint64: %w", src, err)
+		}
+		return n, nil
+	case string:
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("null: couldn't convert %v to uint64: %w", src, err)
+		}
+		return n, nil
+	default:
+		return 0, fmt.Errorf("null: couldn't convert %v (%T) to uint64", src, src)
+	}
+}
+
+func toFloat64(src interface{}) (float64, error) {
+	switch v := src.(type) {
+	case float64:
+		return v, nil
+	case []byte:
+		f, err := strconv.ParseFloat(string(v), 64)
+		if err != nil {
+			return 0, fmt.Errorf("null: couldn't convert %v to float64: %w", src, err)
+		}
+		return f, nil
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("null: couldn't convert %v to float64: %w", src, err)
+		}
+		return f, nil
+	default:
+		return 0, fmt.Errorf("null: couldn't convert %v (%T) to float64", src, src)
+	}
+}
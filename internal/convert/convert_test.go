@@ -0,0 +1,72 @@
+package convert
+
+import "testing"
+
+func TestConvertAssignOverflow(t *testing.T) {
+	cases := []struct {
+		name string
+		dest interface{}
+		src  interface{}
+	}{
+		{"int8 too large", new(int8), int64(300)},
+		{"int8 too small", new(int8), int64(-300)},
+		{"uint16 negative", new(uint16), int64(-1)},
+		{"uint16 too large", new(uint16), int64(1 << 20)},
+		{"uint32 too large", new(uint32), int64(1 << 40)},
+		{"uint negative", new(uint), int64(-1)},
+	}
+	for _, tc := range cases {
+		if err := ConvertAssign(tc.dest, tc.src); err == nil {
+			t.Errorf("%s: ConvertAssign(%v): want overflow error, got nil", tc.name, tc.src)
+		}
+	}
+}
+
+func TestConvertAssignSuccess(t *testing.T) {
+	var i8 int8
+	if err := ConvertAssign(&i8, int64(-42)); err != nil {
+		t.Fatalf("ConvertAssign(int8, -42): %v", err)
+	}
+	if i8 != -42 {
+		t.Errorf("got %d, want -42", i8)
+	}
+
+	var u16 uint16
+	if err := ConvertAssign(&u16, int64(65535)); err != nil {
+		t.Fatalf("ConvertAssign(uint16, 65535): %v", err)
+	}
+	if u16 != 65535 {
+		t.Errorf("got %d, want 65535", u16)
+	}
+
+	var u32 uint32
+	if err := ConvertAssign(&u32, []byte("4294967295")); err != nil {
+		t.Fatalf("ConvertAssign(uint32, []byte): %v", err)
+	}
+	if u32 != 4294967295 {
+		t.Errorf("got %d, want 4294967295", u32)
+	}
+
+	var u64 uint64
+	if err := ConvertAssign(&u64, "18446744073709551615"); err != nil {
+		t.Fatalf("ConvertAssign(uint64, string): %v", err)
+	}
+	if u64 != 18446744073709551615 {
+		t.Errorf("got %d, want 18446744073709551615", u64)
+	}
+
+	var f32 float32
+	if err := ConvertAssign(&f32, float64(3.5)); err != nil {
+		t.Fatalf("ConvertAssign(float32, 3.5): %v", err)
+	}
+	if f32 != 3.5 {
+		t.Errorf("got %v, want 3.5", f32)
+	}
+}
+
+func TestConvertAssignUnsupportedDestination(t *testing.T) {
+	var s string
+	if err := ConvertAssign(&s, int64(1)); err == nil {
+		t.Error("ConvertAssign(*string, ...): want error, got nil")
+	}
+}
@@ -0,0 +1,58 @@
+package null
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInt64JSONRoundTrip(t *testing.T) {
+	cases := []int64{math.MaxInt64, math.MinInt64, 0, 42}
+	for _, want := range cases {
+		data, err := Int64From(want).MarshalJSON()
+		if err != nil {
+			t.Fatalf("MarshalJSON(%d): %v", want, err)
+		}
+		var got Int64
+		if err := got.UnmarshalJSON(data); err != nil {
+			t.Fatalf("UnmarshalJSON(%s): %v", data, err)
+		}
+		if !got.Valid || got.Int64 != want {
+			t.Errorf("round trip %d: got %d (valid=%v) via %s", want, got.Int64, got.Valid, data)
+		}
+	}
+}
+
+func TestUint64JSONRoundTrip(t *testing.T) {
+	var want uint64 = math.MaxUint64
+	data, err := Uint64From(want).MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON(%d): %v", want, err)
+	}
+	var got Uint64
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON(%s): %v", data, err)
+	}
+	if !got.Valid || got.Uint64 != want {
+		t.Errorf("round trip %d: got %d (valid=%v) via %s", want, got.Uint64, got.Valid, data)
+	}
+}
+
+func TestParseJSONIntQuotedStringPreservesPrecision(t *testing.T) {
+	var i Int64
+	if err := i.UnmarshalJSON([]byte(`"-9223372036854775808"`)); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	if i.Int64 != math.MinInt64 {
+		t.Errorf("got %d, want %d", i.Int64, int64(math.MinInt64))
+	}
+}
+
+func TestParseJSONUintQuotedStringPreservesPrecision(t *testing.T) {
+	var u Uint64
+	if err := u.UnmarshalJSON([]byte(`"18446744073709551615"`)); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	if u.Uint64 != math.MaxUint64 {
+		t.Errorf("got %d, want %d", u.Uint64, uint64(math.MaxUint64))
+	}
+}
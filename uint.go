@@ -0,0 +1,215 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/voidint/null/internal/convert"
+	"gopkg.in/yaml.v3"
+)
+
+// Uint is a nullable uint.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Uint struct {
+	Uint  uint
+	Valid bool
+}
+
+// NewUint creates a new Uint
+func NewUint(i uint, valid bool) Uint {
+	return Uint{
+		Uint:  i,
+		Valid: valid,
+	}
+}
+
+// UintFrom creates a new Uint that will always be valid.
+func UintFrom(i uint) Uint {
+	return NewUint(i, true)
+}
+
+// UintFromPtr creates a new Uint that be null if i is nil.
+func UintFromPtr(i *uint) Uint {
+	if i == nil {
+		return NewUint(0, false)
+	}
+	return NewUint(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Uint) ValueOrZero() uint {
+	if !i.Valid {
+		return 0
+	}
+	return i.Uint
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will not be considered a null Uint.
+func (i *Uint) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	n, err := parseJSONUint(data, strconv.IntSize)
+	if err != nil {
+		return err
+	}
+	i.Uint = uint(n)
+	i.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint if the input is blank.
+// It will return an error if the input is not an integer, blank, or "null".
+func (i *Uint) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		i.Valid = false
+		return nil
+	}
+	n, err := strconv.ParseUint(str, 10, strconv.IntSize)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	i.Uint = uint(n)
+	i.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Uint is null.
+func (i Uint) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatUint(uint64(i.Uint), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Uint is null.
+func (i Uint) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return []byte{}, nil
+	}
+	return []byte(strconv.FormatUint(uint64(i.Uint), 10)), nil
+}
+
+// SetValid changes this Uint's value and also sets it to be non-null.
+func (i *Uint) SetValid(n uint) {
+	i.Uint = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Uint's value, or a nil pointer if this Int is null.
+func (i Uint) Ptr() *uint {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Uint
+}
+
+// IsZero returns true for invalid Ints, for future omitempty support (Go 1.4?)
+// A non-null Int with a 0 value will not be considered zero.
+func (i Uint) IsZero() bool {
+	return !i.Valid
+}
+
+// Equal returns true if both ints have the same value or are both null.
+func (i Uint) Equal(other Uint) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Uint == other.Uint)
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *Uint) Scan(value interface{}) error {
+	if value == nil {
+		i.Uint, i.Valid = 0, false
+		return nil
+	}
+	if err := convert.ConvertAssign(&i.Uint, value); err != nil {
+		return err
+	}
+	i.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (i Uint) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return int64(i.Uint), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a single tag byte (0 for null, 1 for valid) followed by the
+// big-endian value bytes when valid. The value is always encoded in 8
+// bytes so the wire format is portable across 32-bit and 64-bit platforms.
+func (i Uint) MarshalBinary() ([]byte, error) {
+	if !i.Valid {
+		return []byte{0}, nil
+	}
+	data := make([]byte, 9)
+	data[0] = 1
+	binary.BigEndian.PutUint64(data[1:], uint64(i.Uint))
+	return data, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Uint) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("null: invalid Uint binary data")
+	}
+	if data[0] == 0 {
+		i.Uint, i.Valid = 0, false
+		return nil
+	}
+	if len(data) != 9 {
+		return fmt.Errorf("null: invalid Uint binary data length %d", len(data))
+	}
+	i.Uint = uint(binary.BigEndian.Uint64(data[1:]))
+	i.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Uint) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Uint) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Uint is null.
+func (i Uint) MarshalYAML() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return i.Uint, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It will unmarshal to a null Uint if the input is null or ~.
+func (i *Uint) UnmarshalYAML(value *yaml.Node) error {
+	if isYAMLNull(value) {
+		i.Valid = false
+		return nil
+	}
+	n, err := parseYAMLUint(value, strconv.IntSize)
+	if err != nil {
+		return err
+	}
+	i.Uint = uint(n)
+	i.Valid = true
+	return nil
+}
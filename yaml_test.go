@@ -0,0 +1,157 @@
+package null
+
+import (
+	"math"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestInt32YAMLRoundTrip(t *testing.T) {
+	for _, tc := range []Int32{Int32From(-42), Int32From(0), NewInt32(0, false)} {
+		data, err := yaml.Marshal(tc)
+		if err != nil {
+			t.Fatalf("yaml.Marshal(%+v): %v", tc, err)
+		}
+		var got Int32
+		if err := yaml.Unmarshal(data, &got); err != nil {
+			t.Fatalf("yaml.Unmarshal(%q): %v", data, err)
+		}
+		if got != tc {
+			t.Errorf("round trip %+v: got %+v via %q", tc, got, data)
+		}
+	}
+}
+
+func TestInt32YAMLLiteralForms(t *testing.T) {
+	cases := []struct {
+		doc  string
+		want int32
+	}{
+		{"0x1A", 26},
+		{"0o644", 420},
+		{"1_000_000", 1000000},
+		{"-7", -7},
+	}
+	for _, tc := range cases {
+		var got Int32
+		if err := yaml.Unmarshal([]byte(tc.doc), &got); err != nil {
+			t.Fatalf("yaml.Unmarshal(%q): %v", tc.doc, err)
+		}
+		if !got.Valid || got.Int32 != tc.want {
+			t.Errorf("yaml.Unmarshal(%q) = %+v, want Int32=%d Valid=true", tc.doc, got, tc.want)
+		}
+	}
+}
+
+func TestInt32YAMLNull(t *testing.T) {
+	for _, doc := range []string{"~", "null"} {
+		var got Int32
+		got.Int32 = 99 // prove UnmarshalYAML actually resets Valid
+		if err := yaml.Unmarshal([]byte(doc), &got); err != nil {
+			t.Fatalf("yaml.Unmarshal(%q): %v", doc, err)
+		}
+		if got.Valid {
+			t.Errorf("yaml.Unmarshal(%q): got Valid=true, want false", doc)
+		}
+	}
+}
+
+func TestUint32YAMLRoundTrip(t *testing.T) {
+	for _, tc := range []Uint32{Uint32From(42), NewUint32(0, false)} {
+		data, err := yaml.Marshal(tc)
+		if err != nil {
+			t.Fatalf("yaml.Marshal(%+v): %v", tc, err)
+		}
+		var got Uint32
+		if err := yaml.Unmarshal(data, &got); err != nil {
+			t.Fatalf("yaml.Unmarshal(%q): %v", data, err)
+		}
+		if got != tc {
+			t.Errorf("round trip %+v: got %+v via %q", tc, got, data)
+		}
+	}
+}
+
+func TestUint32YAMLLiteralForms(t *testing.T) {
+	cases := []struct {
+		doc  string
+		want uint32
+	}{
+		{"0x1A", 26},
+		{"0o644", 420},
+		{"1_000_000", 1000000},
+	}
+	for _, tc := range cases {
+		var got Uint32
+		if err := yaml.Unmarshal([]byte(tc.doc), &got); err != nil {
+			t.Fatalf("yaml.Unmarshal(%q): %v", tc.doc, err)
+		}
+		if !got.Valid || got.Uint32 != tc.want {
+			t.Errorf("yaml.Unmarshal(%q) = %+v, want Uint32=%d Valid=true", tc.doc, got, tc.want)
+		}
+	}
+}
+
+func TestUint32YAMLNull(t *testing.T) {
+	var got Uint32
+	if err := yaml.Unmarshal([]byte("~"), &got); err != nil {
+		t.Fatalf("yaml.Unmarshal: %v", err)
+	}
+	if got.Valid {
+		t.Errorf("got Valid=true, want false")
+	}
+}
+
+func TestFloat32YAMLRoundTrip(t *testing.T) {
+	for _, tc := range []Float32{Float32From(3.14), NewFloat32(0, false)} {
+		data, err := yaml.Marshal(tc)
+		if err != nil {
+			t.Fatalf("yaml.Marshal(%+v): %v", tc, err)
+		}
+		var got Float32
+		if err := yaml.Unmarshal(data, &got); err != nil {
+			t.Fatalf("yaml.Unmarshal(%q): %v", data, err)
+		}
+		if got != tc {
+			t.Errorf("round trip %+v: got %+v via %q", tc, got, data)
+		}
+	}
+}
+
+func TestFloat32YAMLSigils(t *testing.T) {
+	cases := []struct {
+		doc  string
+		want float32
+	}{
+		{".inf", float32(math.Inf(1))},
+		{"-.inf", float32(math.Inf(-1))},
+	}
+	for _, tc := range cases {
+		var got Float32
+		if err := yaml.Unmarshal([]byte(tc.doc), &got); err != nil {
+			t.Fatalf("yaml.Unmarshal(%q): %v", tc.doc, err)
+		}
+		if !got.Valid || got.Float32 != tc.want {
+			t.Errorf("yaml.Unmarshal(%q) = %+v, want Float32=%v Valid=true", tc.doc, got, tc.want)
+		}
+	}
+
+	var nan Float32
+	if err := yaml.Unmarshal([]byte(".nan"), &nan); err != nil {
+		t.Fatalf("yaml.Unmarshal(.nan): %v", err)
+	}
+	if !nan.Valid || !math.IsNaN(float64(nan.Float32)) {
+		t.Errorf("yaml.Unmarshal(.nan) = %+v, want NaN Valid=true", nan)
+	}
+}
+
+func TestFloat32YAMLNull(t *testing.T) {
+	var got Float32
+	if err := yaml.Unmarshal([]byte("~"), &got); err != nil {
+		t.Fatalf("yaml.Unmarshal: %v", err)
+	}
+	if got.Valid {
+		t.Errorf("got Valid=true, want false")
+	}
+}
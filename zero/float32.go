@@ -0,0 +1,218 @@
+package zero
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/voidint/null/internal/convert"
+	"gopkg.in/yaml.v3"
+)
+
+// Float32 is a nullable float32.
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+type Float32 struct {
+	Float32 float32
+	Valid   bool
+}
+
+// NewFloat32 creates a new Float32
+func NewFloat32(f float32, valid bool) Float32 {
+	return Float32{
+		Float32: f,
+		Valid:   valid,
+	}
+}
+
+// Float32From creates a new Float32 that will be null if zero.
+func Float32From(f float32) Float32 {
+	return NewFloat32(f, f != 0)
+}
+
+// Float32FromPtr creates a new Float32 that be null if f is nil.
+func Float32FromPtr(f *float32) Float32 {
+	if f == nil {
+		return NewFloat32(0, false)
+	}
+	n := NewFloat32(*f, true)
+	return n
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (f Float32) ValueOrZero() float32 {
+	if !f.Valid {
+		return 0
+	}
+	return f.Float32
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+// 0 will be considered a null Float32.
+func (f *Float32) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		f.Valid = false
+		return nil
+	}
+
+	n, err := parseJSONFloat(data, 32)
+	if err != nil {
+		return err
+	}
+	f.Float32 = float32(n)
+	f.Valid = f.Float32 != 0
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Float32 if the input is a blank, or zero.
+// It will return an error if the input is not a float, blank, or "null".
+func (f *Float32) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		f.Valid = false
+		return nil
+	}
+	n, err := strconv.ParseFloat(str, 32)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal text: %w", err)
+	}
+	f.Float32 = float32(n)
+	f.Valid = f.Float32 != 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Float32 is null.
+func (f Float32) MarshalJSON() ([]byte, error) {
+	n := f.Float32
+	if !f.Valid {
+		n = 0
+	}
+	return []byte(strconv.FormatFloat(float64(n), 'f', -1, 32)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Float32 is null.
+func (f Float32) MarshalText() ([]byte, error) {
+	n := f.Float32
+	if !f.Valid {
+		n = 0
+	}
+	return []byte(strconv.FormatFloat(float64(n), 'f', -1, 32)), nil
+}
+
+// SetValid changes this Float32's value and also sets it to be non-null.
+func (f *Float32) SetValid(n float32) {
+	f.Float32 = n
+	f.Valid = true
+}
+
+// Ptr returns a pointer to this Float32's value, or a nil pointer if this Float32 is null.
+func (f Float32) Ptr() *float32 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float32
+}
+
+// IsZero returns true for null or zero Float32s, for future omitempty support (Go 1.4?)
+func (f Float32) IsZero() bool {
+	return !f.Valid || f.Float32 == 0
+}
+
+// Equal returns true if both floats have the same value or are both either null or zero.
+func (f Float32) Equal(other Float32) bool {
+	return f.ValueOrZero() == other.ValueOrZero()
+}
+
+// Scan implements the sql.Scanner interface.
+func (f *Float32) Scan(value interface{}) error {
+	if value == nil {
+		f.Float32, f.Valid = 0, false
+		return nil
+	}
+	if err := convert.ConvertAssign(&f.Float32, value); err != nil {
+		return err
+	}
+	f.Valid = f.Float32 != 0
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (f Float32) Value() (driver.Value, error) {
+	if !f.Valid {
+		return nil, nil
+	}
+	return float64(f.Float32), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a single tag byte (0 for null, 1 for valid) followed by the
+// IEEE 754 bits of the value, big-endian, when valid.
+func (f Float32) MarshalBinary() ([]byte, error) {
+	if !f.Valid {
+		return []byte{0}, nil
+	}
+	data := make([]byte, 5)
+	data[0] = 1
+	binary.BigEndian.PutUint32(data[1:], math.Float32bits(f.Float32))
+	return data, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (f *Float32) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("zero: invalid Float32 binary data")
+	}
+	if data[0] == 0 {
+		f.Float32, f.Valid = 0, false
+		return nil
+	}
+	if len(data) != 5 {
+		return fmt.Errorf("zero: invalid Float32 binary data length %d", len(data))
+	}
+	f.Float32 = math.Float32frombits(binary.BigEndian.Uint32(data[1:]))
+	f.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (f Float32) GobEncode() ([]byte, error) {
+	return f.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (f *Float32) GobDecode(data []byte) error {
+	return f.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode 0 if this Float32 is null.
+func (f Float32) MarshalYAML() (interface{}, error) {
+	n := f.Float32
+	if !f.Valid {
+		n = 0
+	}
+	return n, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It will unmarshal to a null Float32 if the input is null, ~, or zero.
+func (f *Float32) UnmarshalYAML(value *yaml.Node) error {
+	if isYAMLNull(value) {
+		f.Valid = false
+		return nil
+	}
+	n, err := parseYAMLFloat(value, 32)
+	if err != nil {
+		return err
+	}
+	f.Float32 = float32(n)
+	f.Valid = f.Float32 != 0
+	return nil
+}
@@ -0,0 +1,59 @@
+package zero
+
+import (
+	"math"
+	"testing"
+)
+
+func testZeroRoundTrip[T Numeric](t *testing.T, want T) {
+	t.Helper()
+
+	z := ZeroFrom(want)
+	data, err := z.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON(%v): %v", want, err)
+	}
+	var gotJSON Zero[T]
+	if err := gotJSON.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON(%s): %v", data, err)
+	}
+	if !gotJSON.Valid || gotJSON.Val != want {
+		t.Errorf("JSON round trip %v: got %v (valid=%v) via %s", want, gotJSON.Val, gotJSON.Valid, data)
+	}
+
+	dv, err := z.Value()
+	if err != nil {
+		t.Fatalf("Value(%v): %v", want, err)
+	}
+	var gotScan Zero[T]
+	if err := gotScan.Scan(dv); err != nil {
+		t.Fatalf("Scan(%v): %v", dv, err)
+	}
+	if !gotScan.Valid || gotScan.Val != want {
+		t.Errorf("Scan/Value round trip %v: got %v (valid=%v) via %v", want, gotScan.Val, gotScan.Valid, dv)
+	}
+}
+
+func TestZeroRoundTripAllNumericKinds(t *testing.T) {
+	testZeroRoundTrip(t, int8(math.MinInt8))
+	testZeroRoundTrip(t, int16(math.MinInt16))
+	testZeroRoundTrip(t, int32(math.MinInt32))
+	testZeroRoundTrip(t, int64(math.MinInt64))
+	testZeroRoundTrip(t, uint(42))
+	testZeroRoundTrip(t, uint8(math.MaxUint8))
+	testZeroRoundTrip(t, uint16(math.MaxUint16))
+	testZeroRoundTrip(t, uint32(math.MaxUint32))
+	testZeroRoundTrip(t, uint64(math.MaxUint64))
+	testZeroRoundTrip(t, float32(3.14))
+}
+
+func TestZeroUnmarshalJSONZero(t *testing.T) {
+	var z Zero[int32]
+	z.Val = 7
+	if err := z.UnmarshalJSON([]byte("0")); err != nil {
+		t.Fatalf("UnmarshalJSON(0): %v", err)
+	}
+	if z.Valid {
+		t.Errorf("got Valid=true, want false")
+	}
+}
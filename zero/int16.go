@@ -3,10 +3,11 @@ package zero
 import (
 	"bytes"
 	"database/sql"
-	"encoding/json"
-	"errors"
+	"encoding/binary"
 	"fmt"
 	"strconv"
+
+	"gopkg.in/yaml.v3"
 )
 
 // Int16 is a nullable int16.
@@ -57,28 +58,11 @@ func (i *Int16) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	if err := json.Unmarshal(data, &i.Int16); err != nil {
-		var typeError *json.UnmarshalTypeError
-		if errors.As(err, &typeError) {
-			// special case: accept string input
-			if typeError.Value != "string" {
-				return fmt.Errorf("zero: JSON input is invalid type (need int or string): %w", err)
-			}
-			var str string
-			if err := json.Unmarshal(data, &str); err != nil {
-				return fmt.Errorf("zero: couldn't unmarshal number string: %w", err)
-			}
-			n, err := strconv.ParseInt(str, 10, 16)
-			if err != nil {
-				return fmt.Errorf("zero: couldn't convert string to int: %w", err)
-			}
-			i.Int16 = int16(n)
-			i.Valid = n != 0
-			return nil
-		}
-		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	n, err := parseJSONInt(data, 16)
+	if err != nil {
+		return err
 	}
-
+	i.Int16 = int16(n)
 	i.Valid = i.Int16 != 0
 	return nil
 }
@@ -144,3 +128,69 @@ func (i Int16) IsZero() bool {
 func (i Int16) Equal(other Int16) bool {
 	return i.ValueOrZero() == other.ValueOrZero()
 }
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a single tag byte (0 for null, 1 for valid) followed by the
+// big-endian value bytes when valid.
+func (i Int16) MarshalBinary() ([]byte, error) {
+	if !i.Valid {
+		return []byte{0}, nil
+	}
+	data := make([]byte, 3)
+	data[0] = 1
+	binary.BigEndian.PutUint16(data[1:], uint16(i.Int16))
+	return data, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Int16) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("zero: invalid Int16 binary data")
+	}
+	if data[0] == 0 {
+		i.Int16, i.Valid = 0, false
+		return nil
+	}
+	if len(data) != 3 {
+		return fmt.Errorf("zero: invalid Int16 binary data length %d", len(data))
+	}
+	i.Int16 = int16(binary.BigEndian.Uint16(data[1:]))
+	i.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Int16) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Int16) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode 0 if this Int is null.
+func (i Int16) MarshalYAML() (interface{}, error) {
+	n := i.Int16
+	if !i.Valid {
+		n = 0
+	}
+	return n, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It will unmarshal to a null Int16 if the input is null, ~, or zero.
+func (i *Int16) UnmarshalYAML(value *yaml.Node) error {
+	if isYAMLNull(value) {
+		i.Valid = false
+		return nil
+	}
+	n, err := parseYAMLInt(value, 16)
+	if err != nil {
+		return err
+	}
+	i.Int16 = int16(n)
+	i.Valid = i.Int16 != 0
+	return nil
+}
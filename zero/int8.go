@@ -0,0 +1,213 @@
+package zero
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+
+	"github.com/voidint/null/internal/convert"
+	"gopkg.in/yaml.v3"
+)
+
+// Int8 is a nullable int8.
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+type Int8 struct {
+	Int8  int8
+	Valid bool
+}
+
+// NewInt8 creates a new Int8
+func NewInt8(i int8, valid bool) Int8 {
+	return Int8{
+		Int8:  i,
+		Valid: valid,
+	}
+}
+
+// Int8From creates a new Int8 that will be null if zero.
+func Int8From(i int8) Int8 {
+	return NewInt8(i, i != 0)
+}
+
+// Int8FromPtr creates a new Int8 that be null if i is nil.
+func Int8FromPtr(i *int8) Int8 {
+	if i == nil {
+		return NewInt8(0, false)
+	}
+	n := NewInt8(*i, true)
+	return n
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int8) ValueOrZero() int8 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int8
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+// 0 will be considered a null Int.
+func (i *Int8) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	n, err := parseJSONInt(data, 8)
+	if err != nil {
+		return err
+	}
+	i.Int8 = int8(n)
+	i.Valid = i.Int8 != 0
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int8 if the input is a blank, or zero.
+// It will return an error if the input is not an integer, blank, or "null".
+func (i *Int8) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		i.Valid = false
+		return nil
+	}
+	n, err := strconv.ParseInt(str, 10, 8)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal text: %w", err)
+	}
+	i.Int8 = int8(n)
+	i.Valid = i.Int8 != 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Int is null.
+func (i Int8) MarshalJSON() ([]byte, error) {
+	n := i.Int8
+	if !i.Valid {
+		n = 0
+	}
+	return []byte(strconv.FormatInt(int64(n), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Int is null.
+func (i Int8) MarshalText() ([]byte, error) {
+	n := i.Int8
+	if !i.Valid {
+		n = 0
+	}
+	return []byte(strconv.FormatInt(int64(n), 10)), nil
+}
+
+// SetValid changes this Int8's value and also sets it to be non-null.
+func (i *Int8) SetValid(n int8) {
+	i.Int8 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Int8's value, or a nil pointer if this Int is null.
+func (i Int8) Ptr() *int8 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int8
+}
+
+// IsZero returns true for null or zero Ints, for future omitempty support (Go 1.4?)
+func (i Int8) IsZero() bool {
+	return !i.Valid || i.Int8 == 0
+}
+
+// Equal returns true if both ints have the same value or are both either null or zero.
+func (i Int8) Equal(other Int8) bool {
+	return i.ValueOrZero() == other.ValueOrZero()
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a single tag byte (0 for null, 1 for valid) followed by the
+// value byte when valid.
+func (i Int8) MarshalBinary() ([]byte, error) {
+	if !i.Valid {
+		return []byte{0}, nil
+	}
+	return []byte{1, byte(i.Int8)}, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Int8) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("zero: invalid Int8 binary data")
+	}
+	if data[0] == 0 {
+		i.Int8, i.Valid = 0, false
+		return nil
+	}
+	if len(data) != 2 {
+		return fmt.Errorf("zero: invalid Int8 binary data length %d", len(data))
+	}
+	i.Int8 = int8(data[1])
+	i.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Int8) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Int8) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode 0 if this Int is null.
+func (i Int8) MarshalYAML() (interface{}, error) {
+	n := i.Int8
+	if !i.Valid {
+		n = 0
+	}
+	return n, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It will unmarshal to a null Int8 if the input is null, ~, or zero.
+func (i *Int8) UnmarshalYAML(value *yaml.Node) error {
+	if isYAMLNull(value) {
+		i.Valid = false
+		return nil
+	}
+	n, err := parseYAMLInt(value, 8)
+	if err != nil {
+		return err
+	}
+	i.Int8 = int8(n)
+	i.Valid = i.Int8 != 0
+	return nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *Int8) Scan(value interface{}) error {
+	if value == nil {
+		i.Int8, i.Valid = 0, false
+		return nil
+	}
+	if err := convert.ConvertAssign(&i.Int8, value); err != nil {
+		return err
+	}
+	i.Valid = i.Int8 != 0
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (i Int8) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return int64(i.Int8), nil
+}
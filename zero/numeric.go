@@ -0,0 +1,158 @@
+package zero
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// parseJSONInt decodes a JSON integer or quoted numeric string directly via
+// strconv, bypassing json.Unmarshal's float64 intermediate so that values up
+// to the full range of int64 keep their precision.
+func parseJSONInt(data []byte, bitSize int) (int64, error) {
+	str := string(data)
+	if len(str) >= 2 && str[0] == '"' && str[len(str)-1] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return 0, fmt.Errorf("zero: couldn't unmarshal number string: %w", err)
+		}
+		n, err := strconv.ParseInt(s, 10, bitSize)
+		if err != nil {
+			return 0, fmt.Errorf("zero: couldn't convert string to int: %w", err)
+		}
+		return n, nil
+	}
+	n, err := strconv.ParseInt(str, 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("zero: JSON input is invalid type (need int or string): %w", err)
+	}
+	return n, nil
+}
+
+// parseJSONUint decodes a JSON unsigned integer or quoted numeric string
+// directly via strconv, bypassing json.Unmarshal's float64 intermediate so
+// that values up to the full range of uint64 keep their precision.
+func parseJSONUint(data []byte, bitSize int) (uint64, error) {
+	str := string(data)
+	if len(str) >= 2 && str[0] == '"' && str[len(str)-1] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return 0, fmt.Errorf("zero: couldn't unmarshal number string: %w", err)
+		}
+		n, err := strconv.ParseUint(s, 10, bitSize)
+		if err != nil {
+			return 0, fmt.Errorf("zero: couldn't convert string to int: %w", err)
+		}
+		return n, nil
+	}
+	n, err := strconv.ParseUint(str, 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("zero: JSON input is invalid type (need int or string): %w", err)
+	}
+	return n, nil
+}
+
+// parseJSONFloat decodes a JSON float or quoted numeric string directly via
+// strconv, the same way parseJSONInt and parseJSONUint do for integers.
+func parseJSONFloat(data []byte, bitSize int) (float64, error) {
+	str := string(data)
+	if len(str) >= 2 && str[0] == '"' && str[len(str)-1] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return 0, fmt.Errorf("zero: couldn't unmarshal number string: %w", err)
+		}
+		f, err := strconv.ParseFloat(s, bitSize)
+		if err != nil {
+			return 0, fmt.Errorf("zero: couldn't convert string to float: %w", err)
+		}
+		return f, nil
+	}
+	f, err := strconv.ParseFloat(str, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("zero: JSON input is invalid type (need float or string): %w", err)
+	}
+	return f, nil
+}
+
+// isYAMLNull reports whether a YAML scalar node represents null (null, ~, or
+// an empty value).
+func isYAMLNull(value *yaml.Node) bool {
+	return value.Tag == "!!null"
+}
+
+// parseYAMLInt decodes a YAML integer scalar, preferring yaml.v3's native
+// numeric resolution so hex (0x1A), octal (0o644), and underscore-grouped
+// (1_000_000) literals parse correctly, and falling back to strconv on
+// quoted string-form input symmetrically with parseJSONInt.
+func parseYAMLInt(value *yaml.Node, bitSize int) (int64, error) {
+	if value.Tag != "!!str" {
+		var n int64
+		if err := value.Decode(&n); err == nil {
+			if _, err := strconv.ParseInt(strconv.FormatInt(n, 10), 10, bitSize); err != nil {
+				return 0, fmt.Errorf("zero: YAML integer overflows %d-bit int: %w", bitSize, err)
+			}
+			return n, nil
+		}
+	}
+	var s string
+	if err := value.Decode(&s); err != nil {
+		return 0, fmt.Errorf("zero: couldn't unmarshal YAML scalar: %w", err)
+	}
+	n, err := strconv.ParseInt(s, 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("zero: YAML input is invalid type (need int or string): %w", err)
+	}
+	return n, nil
+}
+
+// parseYAMLUint decodes a YAML unsigned integer scalar, preferring yaml.v3's
+// native numeric resolution (hex, octal, underscore-grouped literals) and
+// falling back to strconv on quoted string-form input, symmetrically with
+// parseJSONUint.
+func parseYAMLUint(value *yaml.Node, bitSize int) (uint64, error) {
+	if value.Tag != "!!str" {
+		var n uint64
+		if err := value.Decode(&n); err == nil {
+			if _, err := strconv.ParseUint(strconv.FormatUint(n, 10), 10, bitSize); err != nil {
+				return 0, fmt.Errorf("zero: YAML integer overflows %d-bit uint: %w", bitSize, err)
+			}
+			return n, nil
+		}
+	}
+	var s string
+	if err := value.Decode(&s); err != nil {
+		return 0, fmt.Errorf("zero: couldn't unmarshal YAML scalar: %w", err)
+	}
+	n, err := strconv.ParseUint(s, 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("zero: YAML input is invalid type (need uint or string): %w", err)
+	}
+	return n, nil
+}
+
+// parseYAMLFloat decodes a YAML float scalar, preferring yaml.v3's native
+// numeric resolution so sigils like .inf, -.inf, and .nan parse correctly,
+// and falling back to strconv on quoted string-form input.
+func parseYAMLFloat(value *yaml.Node, bitSize int) (float64, error) {
+	if value.Tag != "!!str" {
+		var n float64
+		if err := value.Decode(&n); err == nil {
+			f, err := strconv.ParseFloat(strconv.FormatFloat(n, 'g', -1, 64), bitSize)
+			if err != nil {
+				return 0, fmt.Errorf("zero: YAML float overflows %d-bit float: %w", bitSize, err)
+			}
+			return f, nil
+		}
+	}
+	var s string
+	if err := value.Decode(&s); err != nil {
+		return 0, fmt.Errorf("zero: couldn't unmarshal YAML scalar: %w", err)
+	}
+	n, err := strconv.ParseFloat(s, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("zero: YAML input is invalid type (need float or string): %w", err)
+	}
+	return n, nil
+}
@@ -0,0 +1,218 @@
+package zero
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/voidint/null/internal/convert"
+	"gopkg.in/yaml.v3"
+)
+
+// Uint is a nullable uint.
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+type Uint struct {
+	Uint  uint
+	Valid bool
+}
+
+// NewUint creates a new Uint
+func NewUint(i uint, valid bool) Uint {
+	return Uint{
+		Uint:  i,
+		Valid: valid,
+	}
+}
+
+// UintFrom creates a new Uint that will be null if zero.
+func UintFrom(i uint) Uint {
+	return NewUint(i, i != 0)
+}
+
+// UintFromPtr creates a new Uint that be null if i is nil.
+func UintFromPtr(i *uint) Uint {
+	if i == nil {
+		return NewUint(0, false)
+	}
+	n := NewUint(*i, true)
+	return n
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Uint) ValueOrZero() uint {
+	if !i.Valid {
+		return 0
+	}
+	return i.Uint
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+// 0 will be considered a null Int.
+func (i *Uint) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	n, err := parseJSONUint(data, strconv.IntSize)
+	if err != nil {
+		return err
+	}
+	i.Uint = uint(n)
+	i.Valid = i.Uint != 0
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint if the input is a blank, or zero.
+// It will return an error if the input is not an integer, blank, or "null".
+func (i *Uint) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		i.Valid = false
+		return nil
+	}
+	n, err := strconv.ParseUint(str, 10, strconv.IntSize)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal text: %w", err)
+	}
+	i.Uint = uint(n)
+	i.Valid = i.Uint != 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Int is null.
+func (i Uint) MarshalJSON() ([]byte, error) {
+	n := i.Uint
+	if !i.Valid {
+		n = 0
+	}
+	return []byte(strconv.FormatUint(uint64(n), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Int is null.
+func (i Uint) MarshalText() ([]byte, error) {
+	n := i.Uint
+	if !i.Valid {
+		n = 0
+	}
+	return []byte(strconv.FormatUint(uint64(n), 10)), nil
+}
+
+// SetValid changes this Uint's value and also sets it to be non-null.
+func (i *Uint) SetValid(n uint) {
+	i.Uint = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Uint's value, or a nil pointer if this Int is null.
+func (i Uint) Ptr() *uint {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Uint
+}
+
+// IsZero returns true for null or zero Ints, for future omitempty support (Go 1.4?)
+func (i Uint) IsZero() bool {
+	return !i.Valid || i.Uint == 0
+}
+
+// Equal returns true if both ints have the same value or are both either null or zero.
+func (i Uint) Equal(other Uint) bool {
+	return i.ValueOrZero() == other.ValueOrZero()
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *Uint) Scan(value interface{}) error {
+	if value == nil {
+		i.Uint, i.Valid = 0, false
+		return nil
+	}
+	if err := convert.ConvertAssign(&i.Uint, value); err != nil {
+		return err
+	}
+	i.Valid = i.Uint != 0
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (i Uint) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return int64(i.Uint), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a single tag byte (0 for null, 1 for valid) followed by the
+// big-endian value bytes when valid. The value is always encoded in 8
+// bytes so the wire format is portable across 32-bit and 64-bit platforms.
+func (i Uint) MarshalBinary() ([]byte, error) {
+	if !i.Valid {
+		return []byte{0}, nil
+	}
+	data := make([]byte, 9)
+	data[0] = 1
+	binary.BigEndian.PutUint64(data[1:], uint64(i.Uint))
+	return data, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Uint) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("zero: invalid Uint binary data")
+	}
+	if data[0] == 0 {
+		i.Uint, i.Valid = 0, false
+		return nil
+	}
+	if len(data) != 9 {
+		return fmt.Errorf("zero: invalid Uint binary data length %d", len(data))
+	}
+	i.Uint = uint(binary.BigEndian.Uint64(data[1:]))
+	i.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Uint) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Uint) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode 0 if this Int is null.
+func (i Uint) MarshalYAML() (interface{}, error) {
+	n := i.Uint
+	if !i.Valid {
+		n = 0
+	}
+	return n, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It will unmarshal to a null Uint if the input is null, ~, or zero.
+func (i *Uint) UnmarshalYAML(value *yaml.Node) error {
+	if isYAMLNull(value) {
+		i.Valid = false
+		return nil
+	}
+	n, err := parseYAMLUint(value, strconv.IntSize)
+	if err != nil {
+		return err
+	}
+	i.Uint = uint(n)
+	i.Valid = i.Uint != 0
+	return nil
+}
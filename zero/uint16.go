@@ -0,0 +1,217 @@
+package zero
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/voidint/null/internal/convert"
+	"gopkg.in/yaml.v3"
+)
+
+// Uint16 is a nullable uint16.
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+type Uint16 struct {
+	Uint16 uint16
+	Valid  bool
+}
+
+// NewUint16 creates a new Uint16
+func NewUint16(i uint16, valid bool) Uint16 {
+	return Uint16{
+		Uint16: i,
+		Valid:  valid,
+	}
+}
+
+// Uint16From creates a new Uint16 that will be null if zero.
+func Uint16From(i uint16) Uint16 {
+	return NewUint16(i, i != 0)
+}
+
+// Uint16FromPtr creates a new Uint16 that be null if i is nil.
+func Uint16FromPtr(i *uint16) Uint16 {
+	if i == nil {
+		return NewUint16(0, false)
+	}
+	n := NewUint16(*i, true)
+	return n
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Uint16) ValueOrZero() uint16 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Uint16
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+// 0 will be considered a null Int.
+func (i *Uint16) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	n, err := parseJSONUint(data, 16)
+	if err != nil {
+		return err
+	}
+	i.Uint16 = uint16(n)
+	i.Valid = i.Uint16 != 0
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint16 if the input is a blank, or zero.
+// It will return an error if the input is not an integer, blank, or "null".
+func (i *Uint16) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		i.Valid = false
+		return nil
+	}
+	n, err := strconv.ParseUint(str, 10, 16)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal text: %w", err)
+	}
+	i.Uint16 = uint16(n)
+	i.Valid = i.Uint16 != 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Int is null.
+func (i Uint16) MarshalJSON() ([]byte, error) {
+	n := i.Uint16
+	if !i.Valid {
+		n = 0
+	}
+	return []byte(strconv.FormatUint(uint64(n), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Int is null.
+func (i Uint16) MarshalText() ([]byte, error) {
+	n := i.Uint16
+	if !i.Valid {
+		n = 0
+	}
+	return []byte(strconv.FormatUint(uint64(n), 10)), nil
+}
+
+// SetValid changes this Uint16's value and also sets it to be non-null.
+func (i *Uint16) SetValid(n uint16) {
+	i.Uint16 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Uint16's value, or a nil pointer if this Int is null.
+func (i Uint16) Ptr() *uint16 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Uint16
+}
+
+// IsZero returns true for null or zero Ints, for future omitempty support (Go 1.4?)
+func (i Uint16) IsZero() bool {
+	return !i.Valid || i.Uint16 == 0
+}
+
+// Equal returns true if both ints have the same value or are both either null or zero.
+func (i Uint16) Equal(other Uint16) bool {
+	return i.ValueOrZero() == other.ValueOrZero()
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *Uint16) Scan(value interface{}) error {
+	if value == nil {
+		i.Uint16, i.Valid = 0, false
+		return nil
+	}
+	if err := convert.ConvertAssign(&i.Uint16, value); err != nil {
+		return err
+	}
+	i.Valid = i.Uint16 != 0
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (i Uint16) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return int64(i.Uint16), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a single tag byte (0 for null, 1 for valid) followed by the
+// big-endian value bytes when valid.
+func (i Uint16) MarshalBinary() ([]byte, error) {
+	if !i.Valid {
+		return []byte{0}, nil
+	}
+	data := make([]byte, 3)
+	data[0] = 1
+	binary.BigEndian.PutUint16(data[1:], i.Uint16)
+	return data, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Uint16) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("zero: invalid Uint16 binary data")
+	}
+	if data[0] == 0 {
+		i.Uint16, i.Valid = 0, false
+		return nil
+	}
+	if len(data) != 3 {
+		return fmt.Errorf("zero: invalid Uint16 binary data length %d", len(data))
+	}
+	i.Uint16 = binary.BigEndian.Uint16(data[1:])
+	i.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Uint16) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Uint16) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode 0 if this Int is null.
+func (i Uint16) MarshalYAML() (interface{}, error) {
+	n := i.Uint16
+	if !i.Valid {
+		n = 0
+	}
+	return n, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It will unmarshal to a null Uint16 if the input is null, ~, or zero.
+func (i *Uint16) UnmarshalYAML(value *yaml.Node) error {
+	if isYAMLNull(value) {
+		i.Valid = false
+		return nil
+	}
+	n, err := parseYAMLUint(value, 16)
+	if err != nil {
+		return err
+	}
+	i.Uint16 = uint16(n)
+	i.Valid = i.Uint16 != 0
+	return nil
+}
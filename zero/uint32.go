@@ -0,0 +1,217 @@
+package zero
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/voidint/null/internal/convert"
+	"gopkg.in/yaml.v3"
+)
+
+// Uint32 is a nullable uint32.
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+type Uint32 struct {
+	Uint32 uint32
+	Valid  bool
+}
+
+// NewUint32 creates a new Uint32
+func NewUint32(i uint32, valid bool) Uint32 {
+	return Uint32{
+		Uint32: i,
+		Valid:  valid,
+	}
+}
+
+// Uint32From creates a new Uint32 that will be null if zero.
+func Uint32From(i uint32) Uint32 {
+	return NewUint32(i, i != 0)
+}
+
+// Uint32FromPtr creates a new Uint32 that be null if i is nil.
+func Uint32FromPtr(i *uint32) Uint32 {
+	if i == nil {
+		return NewUint32(0, false)
+	}
+	n := NewUint32(*i, true)
+	return n
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Uint32) ValueOrZero() uint32 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Uint32
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+// 0 will be considered a null Int.
+func (i *Uint32) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	n, err := parseJSONUint(data, 32)
+	if err != nil {
+		return err
+	}
+	i.Uint32 = uint32(n)
+	i.Valid = i.Uint32 != 0
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint32 if the input is a blank, or zero.
+// It will return an error if the input is not an integer, blank, or "null".
+func (i *Uint32) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		i.Valid = false
+		return nil
+	}
+	n, err := strconv.ParseUint(str, 10, 32)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal text: %w", err)
+	}
+	i.Uint32 = uint32(n)
+	i.Valid = i.Uint32 != 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Int is null.
+func (i Uint32) MarshalJSON() ([]byte, error) {
+	n := i.Uint32
+	if !i.Valid {
+		n = 0
+	}
+	return []byte(strconv.FormatUint(uint64(n), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Int is null.
+func (i Uint32) MarshalText() ([]byte, error) {
+	n := i.Uint32
+	if !i.Valid {
+		n = 0
+	}
+	return []byte(strconv.FormatUint(uint64(n), 10)), nil
+}
+
+// SetValid changes this Uint32's value and also sets it to be non-null.
+func (i *Uint32) SetValid(n uint32) {
+	i.Uint32 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Uint32's value, or a nil pointer if this Int is null.
+func (i Uint32) Ptr() *uint32 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Uint32
+}
+
+// IsZero returns true for null or zero Ints, for future omitempty support (Go 1.4?)
+func (i Uint32) IsZero() bool {
+	return !i.Valid || i.Uint32 == 0
+}
+
+// Equal returns true if both ints have the same value or are both either null or zero.
+func (i Uint32) Equal(other Uint32) bool {
+	return i.ValueOrZero() == other.ValueOrZero()
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *Uint32) Scan(value interface{}) error {
+	if value == nil {
+		i.Uint32, i.Valid = 0, false
+		return nil
+	}
+	if err := convert.ConvertAssign(&i.Uint32, value); err != nil {
+		return err
+	}
+	i.Valid = i.Uint32 != 0
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (i Uint32) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return int64(i.Uint32), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a single tag byte (0 for null, 1 for valid) followed by the
+// big-endian value bytes when valid.
+func (i Uint32) MarshalBinary() ([]byte, error) {
+	if !i.Valid {
+		return []byte{0}, nil
+	}
+	data := make([]byte, 5)
+	data[0] = 1
+	binary.BigEndian.PutUint32(data[1:], i.Uint32)
+	return data, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Uint32) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("zero: invalid Uint32 binary data")
+	}
+	if data[0] == 0 {
+		i.Uint32, i.Valid = 0, false
+		return nil
+	}
+	if len(data) != 5 {
+		return fmt.Errorf("zero: invalid Uint32 binary data length %d", len(data))
+	}
+	i.Uint32 = binary.BigEndian.Uint32(data[1:])
+	i.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Uint32) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Uint32) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode 0 if this Int is null.
+func (i Uint32) MarshalYAML() (interface{}, error) {
+	n := i.Uint32
+	if !i.Valid {
+		n = 0
+	}
+	return n, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It will unmarshal to a null Uint32 if the input is null, ~, or zero.
+func (i *Uint32) UnmarshalYAML(value *yaml.Node) error {
+	if isYAMLNull(value) {
+		i.Valid = false
+		return nil
+	}
+	n, err := parseYAMLUint(value, 32)
+	if err != nil {
+		return err
+	}
+	i.Uint32 = uint32(n)
+	i.Valid = i.Uint32 != 0
+	return nil
+}
@@ -0,0 +1,223 @@
+package zero
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/voidint/null/internal/convert"
+	"gopkg.in/yaml.v3"
+)
+
+// Uint64 is a nullable uint64.
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+type Uint64 struct {
+	Uint64 uint64
+	Valid  bool
+}
+
+// NewUint64 creates a new Uint64
+func NewUint64(i uint64, valid bool) Uint64 {
+	return Uint64{
+		Uint64: i,
+		Valid:  valid,
+	}
+}
+
+// Uint64From creates a new Uint64 that will be null if zero.
+func Uint64From(i uint64) Uint64 {
+	return NewUint64(i, i != 0)
+}
+
+// Uint64FromPtr creates a new Uint64 that be null if i is nil.
+func Uint64FromPtr(i *uint64) Uint64 {
+	if i == nil {
+		return NewUint64(0, false)
+	}
+	n := NewUint64(*i, true)
+	return n
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Uint64) ValueOrZero() uint64 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Uint64
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+// 0 will be considered a null Int.
+func (i *Uint64) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	n, err := parseJSONUint(data, 64)
+	if err != nil {
+		return err
+	}
+	i.Uint64 = n
+	i.Valid = i.Uint64 != 0
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint64 if the input is a blank, or zero.
+// It will return an error if the input is not an integer, blank, or "null".
+func (i *Uint64) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		i.Valid = false
+		return nil
+	}
+	n, err := strconv.ParseUint(str, 10, 64)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal text: %w", err)
+	}
+	i.Uint64 = n
+	i.Valid = i.Uint64 != 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Int is null.
+func (i Uint64) MarshalJSON() ([]byte, error) {
+	n := i.Uint64
+	if !i.Valid {
+		n = 0
+	}
+	return []byte(strconv.FormatUint(n, 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Int is null.
+func (i Uint64) MarshalText() ([]byte, error) {
+	n := i.Uint64
+	if !i.Valid {
+		n = 0
+	}
+	return []byte(strconv.FormatUint(n, 10)), nil
+}
+
+// SetValid changes this Uint64's value and also sets it to be non-null.
+func (i *Uint64) SetValid(n uint64) {
+	i.Uint64 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Uint64's value, or a nil pointer if this Int is null.
+func (i Uint64) Ptr() *uint64 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Uint64
+}
+
+// IsZero returns true for null or zero Ints, for future omitempty support (Go 1.4?)
+func (i Uint64) IsZero() bool {
+	return !i.Valid || i.Uint64 == 0
+}
+
+// Equal returns true if both ints have the same value or are both either null or zero.
+func (i Uint64) Equal(other Uint64) bool {
+	return i.ValueOrZero() == other.ValueOrZero()
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *Uint64) Scan(value interface{}) error {
+	if value == nil {
+		i.Uint64, i.Valid = 0, false
+		return nil
+	}
+	if err := convert.ConvertAssign(&i.Uint64, value); err != nil {
+		return err
+	}
+	i.Valid = i.Uint64 != 0
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// Values that overflow int64 are encoded as a decimal string, since
+// database/sql/driver has no native unsigned 64-bit representation.
+func (i Uint64) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	if i.Uint64 > math.MaxInt64 {
+		return strconv.FormatUint(i.Uint64, 10), nil
+	}
+	return int64(i.Uint64), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a single tag byte (0 for null, 1 for valid) followed by the
+// big-endian value bytes when valid.
+func (i Uint64) MarshalBinary() ([]byte, error) {
+	if !i.Valid {
+		return []byte{0}, nil
+	}
+	data := make([]byte, 9)
+	data[0] = 1
+	binary.BigEndian.PutUint64(data[1:], i.Uint64)
+	return data, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Uint64) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("zero: invalid Uint64 binary data")
+	}
+	if data[0] == 0 {
+		i.Uint64, i.Valid = 0, false
+		return nil
+	}
+	if len(data) != 9 {
+		return fmt.Errorf("zero: invalid Uint64 binary data length %d", len(data))
+	}
+	i.Uint64 = binary.BigEndian.Uint64(data[1:])
+	i.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Uint64) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Uint64) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode 0 if this Int is null.
+func (i Uint64) MarshalYAML() (interface{}, error) {
+	n := i.Uint64
+	if !i.Valid {
+		n = 0
+	}
+	return n, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It will unmarshal to a null Uint64 if the input is null, ~, or zero.
+func (i *Uint64) UnmarshalYAML(value *yaml.Node) error {
+	if isYAMLNull(value) {
+		i.Valid = false
+		return nil
+	}
+	n, err := parseYAMLUint(value, 64)
+	if err != nil {
+		return err
+	}
+	i.Uint64 = n
+	i.Valid = i.Uint64 != 0
+	return nil
+}
@@ -3,10 +3,10 @@ package zero
 import (
 	"bytes"
 	"database/sql"
-	"encoding/json"
-	"errors"
 	"fmt"
 	"strconv"
+
+	"gopkg.in/yaml.v3"
 )
 
 // Int is a nullable uint8.
@@ -57,28 +57,11 @@ func (i *Uint8) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	if err := json.Unmarshal(data, &i.Byte); err != nil {
-		var typeError *json.UnmarshalTypeError
-		if errors.As(err, &typeError) {
-			// special case: accept string input
-			if typeError.Value != "string" {
-				return fmt.Errorf("zero: JSON input is invalid type (need int or string): %w", err)
-			}
-			var str string
-			if err := json.Unmarshal(data, &str); err != nil {
-				return fmt.Errorf("zero: couldn't unmarshal number string: %w", err)
-			}
-			n, err := strconv.ParseUint(str, 10, 8)
-			if err != nil {
-				return fmt.Errorf("zero: couldn't convert string to int: %w", err)
-			}
-			i.Byte = uint8(n)
-			i.Valid = n != 0
-			return nil
-		}
-		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	n, err := parseJSONUint(data, 8)
+	if err != nil {
+		return err
 	}
-
+	i.Byte = uint8(n)
 	i.Valid = i.Byte != 0
 	return nil
 }
@@ -144,3 +127,66 @@ func (i Uint8) IsZero() bool {
 func (i Uint8) Equal(other Uint8) bool {
 	return i.ValueOrZero() == other.ValueOrZero()
 }
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It encodes a single tag byte (0 for null, 1 for valid) followed by the
+// value byte when valid.
+func (i Uint8) MarshalBinary() ([]byte, error) {
+	if !i.Valid {
+		return []byte{0}, nil
+	}
+	return []byte{1, i.Byte}, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Uint8) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("zero: invalid Uint8 binary data")
+	}
+	if data[0] == 0 {
+		i.Byte, i.Valid = 0, false
+		return nil
+	}
+	if len(data) != 2 {
+		return fmt.Errorf("zero: invalid Uint8 binary data length %d", len(data))
+	}
+	i.Byte = data[1]
+	i.Valid = true
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Uint8) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Uint8) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode 0 if this Int is null.
+func (i Uint8) MarshalYAML() (interface{}, error) {
+	n := i.Byte
+	if !i.Valid {
+		n = 0
+	}
+	return n, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It will unmarshal to a null Uint8 if the input is null, ~, or zero.
+func (i *Uint8) UnmarshalYAML(value *yaml.Node) error {
+	if isYAMLNull(value) {
+		i.Valid = false
+		return nil
+	}
+	n, err := parseYAMLUint(value, 8)
+	if err != nil {
+		return err
+	}
+	i.Byte = uint8(n)
+	i.Valid = i.Byte != 0
+	return nil
+}